@@ -0,0 +1,82 @@
+// Package greetings builds greeting messages for one or more recipients,
+// in any of the languages registered in Greeting.
+package greetings
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Greeting is the catalog of opening words for a greeting, keyed by
+// locale. To support another language, add an entry here and to
+// supportedTags rather than touching Hello or HelloIn.
+var Greeting = map[language.Tag]string{
+	language.English: "Hello",
+	language.Dutch:   "Hallo",
+	language.German:  "Hallo",
+	language.French:  "Bonjour",
+	language.Chinese: "你好",
+	language.Greek:   "γειά σου",
+}
+
+// supportedTags lists Greeting's keys in a fixed order so matcher index
+// results can be mapped back to a tag; language.English comes first so
+// it is the matcher's fallback.
+var supportedTags = []language.Tag{
+	language.English,
+	language.Dutch,
+	language.German,
+	language.French,
+	language.Chinese,
+	language.Greek,
+}
+
+var matcher = language.NewMatcher(supportedTags)
+
+// MatchTag returns whichever supported tag best matches tags, preferring
+// English when nothing matches or no tag is given.
+func MatchTag(tags ...language.Tag) language.Tag {
+	_, index, _ := matcher.Match(tags...)
+	return supportedTags[index]
+}
+
+// GreetingFor returns the catalog entry that best matches tags,
+// preferring English when nothing matches or no tag is given.
+func GreetingFor(tags ...language.Tag) string {
+	return Greeting[MatchTag(tags...)]
+}
+
+// Hello returns an English greeting for name, e.g. "Hello, Alice!". It
+// returns an error if name is empty or entirely whitespace; callers that
+// want a default greeting must supply the name to use themselves rather
+// than relying on Hello to invent one.
+func Hello(name string) (string, error) {
+	return HelloIn(language.English, name)
+}
+
+// HelloIn returns a greeting for name in the language that best matches
+// tag, falling back to English. It has the same empty-name behavior as
+// Hello.
+func HelloIn(tag language.Tag, name string) (string, error) {
+	if strings.TrimSpace(name) == "" {
+		return "", errors.New("empty name")
+	}
+	return fmt.Sprintf("%s, %s!", GreetingFor(tag), name), nil
+}
+
+// HelloAll returns a greeting for each name in names, keyed by name. It
+// stops at, and returns, the first error Hello produces.
+func HelloAll(names []string) (map[string]string, error) {
+	messages := make(map[string]string, len(names))
+	for _, name := range names {
+		message, err := Hello(name)
+		if err != nil {
+			return nil, err
+		}
+		messages[name] = message
+	}
+	return messages, nil
+}
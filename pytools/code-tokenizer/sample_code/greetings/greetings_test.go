@@ -0,0 +1,117 @@
+package greetings
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestHello(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "valid name", input: "Alice", want: "Hello, Alice!"},
+		{name: "empty name", input: "", wantErr: true},
+		{name: "whitespace only", input: "   ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Hello(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Hello(%q) = %q, nil; want error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Hello(%q) returned error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("Hello(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHelloIn(t *testing.T) {
+	tests := []struct {
+		name  string
+		tag   language.Tag
+		input string
+		want  string
+	}{
+		{name: "english", tag: language.English, input: "Alice", want: "Hello, Alice!"},
+		{name: "dutch", tag: language.Dutch, input: "Bram", want: "Hallo, Bram!"},
+		{name: "french", tag: language.French, input: "Claire", want: "Bonjour, Claire!"},
+		{name: "chinese", tag: language.Chinese, input: "Li", want: "你好, Li!"},
+		{name: "unsupported falls back to english", tag: language.Spanish, input: "Carlos", want: "Hello, Carlos!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HelloIn(tt.tag, tt.input)
+			if err != nil {
+				t.Fatalf("HelloIn(%v, %q) returned error: %v", tt.tag, tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("HelloIn(%v, %q) = %q, want %q", tt.tag, tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := HelloIn(language.English, ""); err == nil {
+		t.Error("HelloIn(English, \"\") = nil error, want error")
+	}
+}
+
+func TestHelloAll(t *testing.T) {
+	t.Run("all valid", func(t *testing.T) {
+		names := []string{"Alice", "Bob"}
+		got, err := HelloAll(names)
+		if err != nil {
+			t.Fatalf("HelloAll(%v) returned error: %v", names, err)
+		}
+		want := map[string]string{
+			"Alice": "Hello, Alice!",
+			"Bob":   "Hello, Bob!",
+		}
+		if len(got) != len(want) {
+			t.Fatalf("HelloAll(%v) = %v, want %v", names, got, want)
+		}
+		for name, message := range want {
+			if got[name] != message {
+				t.Errorf("HelloAll(%v)[%q] = %q, want %q", names, name, got[name], message)
+			}
+		}
+	})
+
+	t.Run("stops at first error", func(t *testing.T) {
+		if _, err := HelloAll([]string{"Alice", "", "Bob"}); err == nil {
+			t.Error("HelloAll with a blank name = nil error, want error")
+		}
+	})
+}
+
+func TestGreetingFor(t *testing.T) {
+	tests := []struct {
+		name string
+		tags []language.Tag
+		want string
+	}{
+		{name: "no tags falls back to english", tags: nil, want: "Hello"},
+		{name: "greek", tags: []language.Tag{language.Greek}, want: "γειά σου"},
+		{name: "unsupported falls back to english", tags: []language.Tag{language.Spanish}, want: "Hello"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GreetingFor(tt.tags...); got != tt.want {
+				t.Errorf("GreetingFor(%v) = %q, want %q", tt.tags, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+
+	"golang.org/x/text/language"
+
+	"github.com/thoughtspot/html-renderer/greetings"
+	"github.com/thoughtspot/html-renderer/render"
+	"github.com/thoughtspot/html-renderer/render/gohtml"
+	"github.com/thoughtspot/html-renderer/render/gotxt"
+	"github.com/thoughtspot/html-renderer/templating"
+)
+
+// DefaultName is used when the caller gives no name at all, as opposed
+// to greetings.Hello's empty-name error, which signals bad input.
+const DefaultName = "World"
+
+//go:embed templates
+var templatesFS embed.FS
+
+func init() {
+	templates, err := fs.Sub(templatesFS, "templates")
+	if err != nil {
+		panic(err)
+	}
+	render.AddEngine(gohtml.New(templates), gotxt.New(templates))
+}
+
+// message is the JSON shape emitted by -format=json.
+type message struct {
+	Greeting string `json:"greeting"`
+	Name     string `json:"name"`
+	Message  string `json:"message"`
+}
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		os.Exit(runServe(os.Stdout, os.Stderr, os.Args[2:]))
+	}
+	os.Exit(run(os.Stdout, os.Stderr, os.Args[1:]))
+}
+
+// run implements the CLI and returns the process exit code. It takes w
+// and ew instead of writing to os.Stdout/os.Stderr directly so tests can
+// exercise it without touching the real streams.
+func run(w, ew io.Writer, args []string) int {
+	flags := flag.NewFlagSet("html-renderer", flag.ContinueOnError)
+	flags.SetOutput(ew)
+
+	greeting := flags.String("greeting", "", "word used to open the greeting; overrides -lang")
+	lang := flags.String("lang", "en", "BCP 47 language tag used to pick the greeting word from greetings.Greeting")
+	tmpl := flags.String("template", "", "template name to execute (defaults based on -format)")
+	format := flags.String("format", "text", "output format: text, html, or json")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	tag, err := language.Parse(*lang)
+	if err != nil {
+		fmt.Fprintf(ew, "html-renderer: invalid -lang %q: %v\n", *lang, err)
+		return 2
+	}
+
+	names := flags.Args()
+	if len(names) == 0 {
+		names = []string{defaultRecipient()}
+	}
+
+	templateName := *tmpl
+	if templateName == "" {
+		switch *format {
+		case "text":
+			templateName = "greeting.txt.gotxt"
+		case "html":
+			templateName = "greeting.html.gohtml"
+		case "json":
+			// json is rendered directly, without the template engine.
+		default:
+			fmt.Fprintf(ew, "html-renderer: unknown -format %q\n", *format)
+			return 2
+		}
+	}
+
+	// Validate every name up front so a blank name late in the list
+	// doesn't surface only after earlier names have already been
+	// written to w.
+	if _, err := greetings.HelloAll(names); err != nil {
+		fmt.Fprintln(ew, err)
+		return 1
+	}
+
+	for _, name := range names {
+		greetingWord := *greeting
+		if greetingWord == "" {
+			greetingWord = greetings.GreetingFor(tag)
+		}
+
+		if *format == "json" {
+			msg := message{Greeting: greetingWord, Name: name, Message: fmt.Sprintf("%s, %s!", greetingWord, name)}
+			if err := json.NewEncoder(w).Encode(msg); err != nil {
+				fmt.Fprintln(ew, err)
+				return 1
+			}
+			continue
+		}
+
+		data := struct{ Greeting, Name string }{Greeting: greetingWord, Name: name}
+		if err := templating.Execute(context.Background(), w, templateName, data); err != nil {
+			fmt.Fprintln(ew, err)
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// defaultRecipient resolves the recipient used when no names are given
+// on the command line: the USERNAME environment variable, falling back
+// to DefaultName.
+func defaultRecipient() string {
+	if userName := os.Getenv("USERNAME"); userName != "" {
+		return userName
+	}
+	return DefaultName
+}
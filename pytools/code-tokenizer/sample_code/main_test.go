@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:       "default text format",
+			args:       []string{"Alice"},
+			wantCode:   0,
+			wantStdout: "Hello, Alice!\n",
+		},
+		{
+			name:       "json format",
+			args:       []string{"-format=json", "Alice"},
+			wantCode:   0,
+			wantStdout: "{\"greeting\":\"Hello\",\"name\":\"Alice\",\"message\":\"Hello, Alice!\"}\n",
+		},
+		{
+			name:       "html format",
+			args:       []string{"-format=html", "Alice"},
+			wantCode:   0,
+			wantStdout: "Hello, Alice!\n",
+		},
+		{
+			name:       "lang flag picks catalog entry",
+			args:       []string{"-lang=nl", "Bram"},
+			wantCode:   0,
+			wantStdout: "Hallo, Bram!\n",
+		},
+		{
+			name:       "greeting flag overrides lang",
+			args:       []string{"-lang=nl", "-greeting=Yo", "Bram"},
+			wantCode:   0,
+			wantStdout: "Yo, Bram!\n",
+		},
+		{
+			name:       "invalid lang",
+			args:       []string{"-lang=???", "Alice"},
+			wantCode:   2,
+			wantStderr: "invalid -lang",
+		},
+		{
+			name:       "unknown format",
+			args:       []string{"-format=xml", "Alice"},
+			wantCode:   2,
+			wantStderr: "unknown -format",
+		},
+		{
+			name:       "blank name fails before writing output",
+			args:       []string{"Alice", "  ", "Bob"},
+			wantCode:   1,
+			wantStdout: "",
+			wantStderr: "empty name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(&stdout, &stderr, tt.args)
+
+			if code != tt.wantCode {
+				t.Errorf("run(%v) code = %d, want %d (stderr: %s)", tt.args, code, tt.wantCode, stderr.String())
+			}
+			if stdout.String() != tt.wantStdout {
+				t.Errorf("run(%v) stdout = %q, want %q", tt.args, stdout.String(), tt.wantStdout)
+			}
+			if tt.wantStderr != "" && !strings.Contains(stderr.String(), tt.wantStderr) {
+				t.Errorf("run(%v) stderr = %q, want substring %q", tt.args, stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Package gohtml adapts the standard library's html/template package to
+// the render.Engine interface.
+package gohtml
+
+import (
+	"context"
+	"html/template"
+	"io"
+	"io/fs"
+	"path"
+	"sync"
+
+	"github.com/thoughtspot/html-renderer/render"
+)
+
+// Engine executes html/template templates loaded from an fs.FS. Parsed
+// templates are cached by name since html/template parsing is not cheap
+// and templates are immutable once loaded.
+type Engine struct {
+	fsys fs.FS
+
+	mu    sync.Mutex
+	cache map[string]*template.Template
+}
+
+// New returns an Engine that loads templates out of fsys.
+func New(fsys fs.FS) *Engine {
+	return &Engine{fsys: fsys, cache: make(map[string]*template.Template)}
+}
+
+// Name implements render.Engine.
+func (e *Engine) Name() string { return "gohtml" }
+
+// Execute implements render.Engine.
+func (e *Engine) Execute(ctx context.Context, w io.Writer, name string, data any, opts ...render.Option) error {
+	cfg := render.NewConfig(opts...)
+
+	t, err := e.template(name, cfg)
+	if err != nil {
+		return err
+	}
+	return t.ExecuteTemplate(w, path.Base(name), data)
+}
+
+func (e *Engine) template(name string, cfg render.Config) (*template.Template, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if t, ok := e.cache[name]; ok && len(cfg.Funcs) == 0 {
+		return t, nil
+	}
+
+	t, err := template.New(path.Base(name)).Funcs(cfg.Funcs).ParseFS(e.fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.Funcs) == 0 {
+		e.cache[name] = t
+	}
+	return t, nil
+}
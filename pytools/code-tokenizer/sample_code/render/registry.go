@@ -0,0 +1,52 @@
+package render
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// registry maps a template's file-extension suffix (the part after the
+// last dot, e.g. "gohtml") to the Engine that knows how to execute it.
+type registry struct {
+	engines map[string]Engine
+}
+
+// defaultRegistry is the process-wide set of engines. Most callers go
+// through AddEngine and the templating package rather than constructing
+// their own registry.
+var defaultRegistry = &registry{engines: make(map[string]Engine)}
+
+// AddEngine registers one or more engines with the default registry,
+// keyed by Engine.Name. Registering an engine under a name that is
+// already taken replaces the previous one.
+func AddEngine(engines ...Engine) {
+	for _, e := range engines {
+		defaultRegistry.engines[e.Name()] = e
+	}
+}
+
+// Lookup returns the engine responsible for name, chosen by the suffix
+// following the last "." in name (e.g. "greeting.html.gohtml" -> "gohtml").
+func Lookup(name string) (Engine, error) {
+	suffix := name
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		suffix = name[i+1:]
+	}
+	e, ok := defaultRegistry.engines[suffix]
+	if !ok {
+		return nil, fmt.Errorf("render: no engine registered for suffix %q (template %q)", suffix, name)
+	}
+	return e, nil
+}
+
+// Execute looks up the engine for name and executes it. It is the
+// building block behind templating.Execute.
+func Execute(ctx context.Context, w io.Writer, name string, data any, opts ...Option) error {
+	e, err := Lookup(name)
+	if err != nil {
+		return err
+	}
+	return e.Execute(ctx, w, name, data, opts...)
+}
@@ -0,0 +1,52 @@
+// Package render defines the pluggable template engine subsystem used to
+// turn a named template plus data into output bytes. Concrete engines
+// (gohtml, gotxt, ...) implement Engine and are registered with AddEngine
+// by the program that uses them, so callers never need to know which
+// backend a given template suffix maps to.
+package render
+
+import (
+	"context"
+	"io"
+)
+
+// Engine renders a named template against data, writing the result to w.
+// Implementations wrap a specific Go template package (html/template,
+// text/template, ...) or a third-party engine (Pug, Mustache, ...).
+type Engine interface {
+	// Name identifies the engine, e.g. "gohtml" or "gotxt". It is matched
+	// against the final suffix of a template name by the registry.
+	Name() string
+	Execute(ctx context.Context, w io.Writer, name string, data any, opts ...Option) error
+}
+
+// Config carries the settings an Option can mutate. Engines read whatever
+// fields are relevant to them.
+type Config struct {
+	Funcs map[string]any
+}
+
+// Option customizes a single Execute call.
+type Option func(*Config)
+
+// WithFuncs makes the given functions available to the template under
+// execution, in addition to whatever the engine registers by default.
+func WithFuncs(funcs map[string]any) Option {
+	return func(c *Config) {
+		if c.Funcs == nil {
+			c.Funcs = make(map[string]any, len(funcs))
+		}
+		for name, fn := range funcs {
+			c.Funcs[name] = fn
+		}
+	}
+}
+
+// NewConfig applies opts over the zero Config and returns the result.
+func NewConfig(opts ...Option) Config {
+	var cfg Config
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
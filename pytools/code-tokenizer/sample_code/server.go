@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/thoughtspot/html-renderer/greetings"
+	"github.com/thoughtspot/html-renderer/templating"
+)
+
+// shutdownTimeout bounds how long serve waits for in-flight requests to
+// finish once a shutdown signal arrives.
+const shutdownTimeout = 5 * time.Second
+
+// runServe starts the HTTP server and blocks until it is shut down by
+// SIGINT/SIGTERM or fails outright, returning the process exit code.
+func runServe(w, ew io.Writer, args []string) int {
+	flags := flag.NewFlagSet("html-renderer serve", flag.ContinueOnError)
+	flags.SetOutput(ew)
+	addr := flags.String("addr", ":8080", "address to listen on")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", handleHealthz)
+	mux.HandleFunc("/greet", handleGreet)
+	mux.HandleFunc("/greet/", handleGreet)
+
+	srv := &http.Server{Addr: *addr, Handler: mux}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		fmt.Fprintf(w, "listening on %s\n", srv.Addr)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			serveErr <- err
+			return
+		}
+		serveErr <- nil
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			fmt.Fprintln(ew, err)
+			return 1
+		}
+		return 0
+	case err := <-serveErr:
+		if err != nil {
+			fmt.Fprintln(ew, err)
+			return 1
+		}
+		return 0
+	}
+}
+
+func handleHealthz(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintln(w, "ok")
+}
+
+// handleGreet serves both GET /greet/{name} and GET /greet?name=..., and
+// content-negotiates the response body on the Accept header.
+func handleGreet(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, "/greet/")
+	if name == "" || name == r.URL.Path {
+		name = r.URL.Query().Get("name")
+	}
+	if name == "" {
+		name = DefaultName
+	}
+
+	acceptTags, _, _ := language.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+	tag := greetings.MatchTag(acceptTags...)
+
+	if _, err := greetings.HelloIn(tag, name); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	greeting := greetings.Greeting[tag]
+	data := struct{ Greeting, Name string }{Greeting: greeting, Name: name}
+
+	switch negotiateFormat(r.Header.Get("Accept")) {
+	case "json":
+		w.Header().Set("Content-Type", "application/json")
+		msg := message{Greeting: greeting, Name: name, Message: fmt.Sprintf("%s, %s!", greeting, name)}
+		if err := json.NewEncoder(w).Encode(msg); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	case "text":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if err := templating.Execute(r.Context(), w, "greeting.txt.gotxt", data); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	default:
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := templating.Execute(r.Context(), w, "greeting.html.gohtml", data); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// negotiateFormat maps an Accept header to one of "json", "text", or
+// "html", defaulting to "html" when nothing more specific matches.
+func negotiateFormat(accept string) string {
+	switch {
+	case strings.Contains(accept, "application/json"):
+		return "json"
+	case strings.Contains(accept, "text/plain"):
+		return "text"
+	default:
+		return "html"
+	}
+}
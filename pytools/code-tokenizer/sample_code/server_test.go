@@ -0,0 +1,145 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandleHealthz(t *testing.T) {
+	rec := httptest.NewRecorder()
+	handleHealthz(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("handleHealthz status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if got := rec.Body.String(); got != "ok\n" {
+		t.Errorf("handleHealthz body = %q, want %q", got, "ok\n")
+	}
+}
+
+func TestHandleGreet(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		accept         string
+		acceptLanguage string
+		wantCode       int
+		wantType       string
+		wantBody       string
+	}{
+		{
+			name:     "path param, default html",
+			target:   "/greet/Alice",
+			wantCode: http.StatusOK,
+			wantType: "text/html; charset=utf-8",
+			wantBody: "Hello, Alice!\n",
+		},
+		{
+			name:     "query param, default html",
+			target:   "/greet?name=Alice",
+			wantCode: http.StatusOK,
+			wantType: "text/html; charset=utf-8",
+			wantBody: "Hello, Alice!\n",
+		},
+		{
+			name:     "text accept",
+			target:   "/greet/Alice",
+			accept:   "text/plain",
+			wantCode: http.StatusOK,
+			wantType: "text/plain; charset=utf-8",
+			wantBody: "Hello, Alice!\n",
+		},
+		{
+			name:     "json accept",
+			target:   "/greet/Alice",
+			accept:   "application/json",
+			wantCode: http.StatusOK,
+			wantType: "application/json",
+			wantBody: "{\"greeting\":\"Hello\",\"name\":\"Alice\",\"message\":\"Hello, Alice!\"}\n",
+		},
+		{
+			name:           "accept-language picks catalog entry",
+			target:         "/greet/Bram",
+			acceptLanguage: "nl",
+			wantCode:       http.StatusOK,
+			wantType:       "text/html; charset=utf-8",
+			wantBody:       "Hallo, Bram!\n",
+		},
+		{
+			name:     "no name falls back to default",
+			target:   "/greet",
+			wantCode: http.StatusOK,
+			wantType: "text/html; charset=utf-8",
+			wantBody: "Hello, " + DefaultName + "!\n",
+		},
+		{
+			name:     "blank path name falls back to default",
+			target:   "/greet/",
+			wantCode: http.StatusOK,
+			wantType: "text/html; charset=utf-8",
+			wantBody: "Hello, " + DefaultName + "!\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+
+			rec := httptest.NewRecorder()
+			handleGreet(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("handleGreet(%q) status = %d, want %d (body: %s)", tt.target, rec.Code, tt.wantCode, rec.Body.String())
+			}
+			if got := rec.Header().Get("Content-Type"); got != tt.wantType {
+				t.Errorf("handleGreet(%q) Content-Type = %q, want %q", tt.target, got, tt.wantType)
+			}
+			if got := rec.Body.String(); got != tt.wantBody {
+				t.Errorf("handleGreet(%q) body = %q, want %q", tt.target, got, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestNegotiateFormat(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{accept: "application/json", want: "json"},
+		{accept: "application/json, text/plain;q=0.9", want: "json"},
+		{accept: "text/plain", want: "text"},
+		{accept: "text/html", want: "html"},
+		{accept: "", want: "html"},
+		{accept: "*/*", want: "html"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			if got := negotiateFormat(tt.accept); got != tt.want {
+				t.Errorf("negotiateFormat(%q) = %q, want %q", tt.accept, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandleGreetBlankName(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/greet?name=%20%20", nil)
+	rec := httptest.NewRecorder()
+	handleGreet(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("handleGreet status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if !strings.Contains(rec.Body.String(), "empty name") {
+		t.Errorf("handleGreet body = %q, want substring %q", rec.Body.String(), "empty name")
+	}
+}
@@ -0,0 +1,19 @@
+// Package templating is the façade most callers use to render a
+// template: it hides the render package's registry and engine
+// interfaces behind a single Execute function.
+package templating
+
+import (
+	"context"
+	"io"
+
+	"github.com/thoughtspot/html-renderer/render"
+)
+
+// Execute renders the template named name with data, writing the result
+// to w. The engine is chosen by the render registry based on name's
+// suffix (see render.Lookup); it returns an error if no engine is
+// registered for that suffix.
+func Execute(ctx context.Context, w io.Writer, name string, data any, opts ...render.Option) error {
+	return render.Execute(ctx, w, name, data, opts...)
+}